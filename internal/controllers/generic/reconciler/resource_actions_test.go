@@ -0,0 +1,93 @@
+package reconciler
+
+import (
+	"errors"
+	"iter"
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestAtMostOne(t *testing.T) {
+	errMultiple := errors.New("multiple results")
+	errPaging := errors.New("paging error")
+
+	// iterator yields values in order, yielding errPaging in place of the
+	// value at index errorOn. It records how many items were requested.
+	iterator := func(pulled *int, errorOn int, values ...int) iter.Seq2[*int, error] {
+		return func(yield func(*int, error) bool) {
+			for i, v := range values {
+				*pulled++
+				if i == errorOn {
+					_ = yield(nil, errPaging)
+					return
+				}
+				if !yield(ptr.To(v), nil) {
+					return
+				}
+			}
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		errorOn    int
+		values     []int
+		wantResult *int
+		wantErr    error
+		wantPulled int
+	}{
+		{
+			name:       "No results",
+			errorOn:    -1,
+			wantPulled: 0,
+		},
+		{
+			name:       "One result",
+			errorOn:    -1,
+			values:     []int{1},
+			wantResult: ptr.To(1),
+			wantPulled: 1,
+		},
+		{
+			name:       "Multiple results stops after the second",
+			errorOn:    -1,
+			values:     []int{1, 2, 3, 4},
+			wantErr:    errMultiple,
+			wantPulled: 2,
+		},
+		{
+			name:       "Error on first result",
+			errorOn:    0,
+			values:     []int{1, 2},
+			wantErr:    errPaging,
+			wantPulled: 1,
+		},
+		{
+			name:       "Error after first result is not treated as a single match",
+			errorOn:    1,
+			values:     []int{1, 2},
+			wantErr:    errPaging,
+			wantPulled: 2,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			pulled := 0
+			got, err := atMostOne(iterator(&pulled, tt.errorOn, tt.values...), errMultiple)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantResult == nil && got != nil {
+				t.Errorf("Expected no result, got %d", *got)
+			}
+			if tt.wantResult != nil && (got == nil || *got != *tt.wantResult) {
+				t.Errorf("Expected result %d, got %v", *tt.wantResult, got)
+			}
+			if pulled != tt.wantPulled {
+				t.Errorf("Expected %d results to be requested, got %d", tt.wantPulled, pulled)
+			}
+		})
+	}
+}
@@ -67,19 +67,30 @@ func JustOne[T any, R Result[*T]](in <-chan R, duplicateError error) (*T, error)
 	return found, nil
 }
 
+// listPages returns an iterator over all resources returned by pager. Any
+// error, including a failure to fetch a subsequent page, is yielded exactly
+// once and ends the iteration.
+func listPages[osResourcePT *osResourceT, osResourceT any](ctx context.Context, pager pagination.Pager, extracter func(pagination.Page) ([]osResourceT, error)) iter.Seq2[osResourcePT, error] {
+	return func(yield func(osResourcePT, error) bool) {
+		if err := pager.EachPage(ctx, yieldPage(extracter, yield)); err != nil {
+			_ = yield(nil, err)
+		}
+	}
+}
+
+// yieldPage returns a pagination handler which yields every item in a page.
+// Errors are not yielded, but returned to EachPage: they will be yielded by
+// listPages.
 func yieldPage[osResourcePT *osResourceT, osResourceT any](extracter func(pagination.Page) ([]osResourceT, error), yield func(osResourcePT, error) bool) func(context.Context, pagination.Page) (bool, error) {
 	return func(ctx context.Context, page pagination.Page) (bool, error) {
 		pageItems, err := extracter(page)
 		if err != nil {
-			_ = yield(nil, err)
 			return false, err
 		}
 		for i := range pageItems {
 			select {
 			case <-ctx.Done():
-				err := ctx.Err()
-				_ = yield(nil, err)
-				return false, err
+				return false, ctx.Err()
 			default:
 				if !yield(&pageItems[i], nil) {
 					return false, nil
@@ -138,16 +138,12 @@ func (c networkClient) ReplaceAllAttributesTags(ctx context.Context, resourceTyp
 
 func (c networkClient) ListRouter(ctx context.Context, opts routers.ListOpts) iter.Seq2[*routers.Router, error] {
 	pager := routers.List(c.serviceClient, opts)
-	return func(yield func(*routers.Router, error) bool) {
-		_ = pager.EachPage(ctx, yieldPage(routers.ExtractRouters, yield))
-	}
+	return listPages(ctx, pager, routers.ExtractRouters)
 }
 
 func (c networkClient) ListFloatingIP(ctx context.Context, opts floatingips.ListOptsBuilder) iter.Seq2[*floatingips.FloatingIP, error] {
 	pager := floatingips.List(c.serviceClient, opts)
-	return func(yield func(*floatingips.FloatingIP, error) bool) {
-		_ = pager.EachPage(ctx, yieldPage(floatingips.ExtractFloatingIPs, yield))
-	}
+	return listPages(ctx, pager, floatingips.ExtractFloatingIPs)
 }
 
 func (c networkClient) CreateFloatingIP(ctx context.Context, opts floatingips.CreateOptsBuilder) (*floatingips.FloatingIP, error) {
@@ -180,9 +176,7 @@ func (c networkClient) ListPort(ctx context.Context, opts ports.ListOptsBuilder)
 		return resources, nil
 	}
 	pager := ports.List(c.serviceClient, opts)
-	return func(yield func(*PortExt, error) bool) {
-		_ = pager.EachPage(ctx, yieldPage(extractPortExt, yield))
-	}
+	return listPages(ctx, pager, extractPortExt)
 }
 
 func (c networkClient) CreatePort(ctx context.Context, opts ports.CreateOptsBuilder) (*PortExt, error) {
@@ -257,9 +251,7 @@ func (c networkClient) UpdateRouter(ctx context.Context, id string, opts routers
 
 func (c networkClient) ListSecGroup(ctx context.Context, opts groups.ListOpts) iter.Seq2[*groups.SecGroup, error] {
 	pager := groups.List(c.serviceClient, opts)
-	return func(yield func(*groups.SecGroup, error) bool) {
-		_ = pager.EachPage(ctx, yieldPage(groups.ExtractGroups, yield))
-	}
+	return listPages(ctx, pager, groups.ExtractGroups)
 }
 
 func (c networkClient) CreateSecGroup(ctx context.Context, opts groups.CreateOptsBuilder) (*groups.SecGroup, error) {
@@ -308,9 +300,7 @@ func (c networkClient) ListNetwork(ctx context.Context, opts networks.ListOptsBu
 		return resources, nil
 	}
 	pager := networks.List(c.serviceClient, opts)
-	return func(yield func(*NetworkExt, error) bool) {
-		_ = pager.EachPage(ctx, yieldPage(extractNetworkExt, yield))
-	}
+	return listPages(ctx, pager, extractNetworkExt)
 }
 
 func (c networkClient) CreateNetwork(ctx context.Context, opts networks.CreateOptsBuilder) (*NetworkExt, error) {
@@ -344,9 +334,7 @@ func (c networkClient) UpdateNetwork(ctx context.Context, id string, opts networ
 
 func (c networkClient) ListSubnet(ctx context.Context, opts subnets.ListOptsBuilder) iter.Seq2[*subnets.Subnet, error] {
 	pager := subnets.List(c.serviceClient, opts)
-	return func(yield func(*subnets.Subnet, error) bool) {
-		_ = pager.EachPage(ctx, yieldPage(subnets.ExtractSubnets, yield))
-	}
+	return listPages(ctx, pager, subnets.ExtractSubnets)
 }
 
 func (c networkClient) CreateSubnet(ctx context.Context, opts subnets.CreateOptsBuilder) (*subnets.Subnet, error) {
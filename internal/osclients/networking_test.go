@@ -0,0 +1,93 @@
+package osclients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	fakeclient "github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestListNetworkPaging(t *testing.T) {
+	const (
+		pageOK      = "ok"
+		pageFailure = "fail"
+	)
+
+	testCases := []struct {
+		name      string
+		pages     []string
+		wantIDs   []string
+		wantError bool
+	}{
+		{
+			name:    "All pages succeed",
+			pages:   []string{pageOK, pageOK},
+			wantIDs: []string{"network-0", "network-1"},
+		},
+		{
+			name:      "First page fails",
+			pages:     []string{pageFailure},
+			wantError: true,
+		},
+		{
+			name:      "Second page fails",
+			pages:     []string{pageOK, pageFailure},
+			wantIDs:   []string{"network-0"},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeServer := th.SetupHTTP()
+			defer fakeServer.Teardown()
+
+			// Each page contains a single network. Every page except the
+			// last links to the next page using its index as the marker.
+			fakeServer.Mux.HandleFunc("/networks", func(w http.ResponseWriter, r *http.Request) {
+				page := 0
+				if marker := r.URL.Query().Get("marker"); marker != "" {
+					_, _ = fmt.Sscanf(marker, "%d", &page)
+				}
+				if page >= len(tt.pages) || tt.pages[page] == pageFailure {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+
+				links := "[]"
+				if page+1 < len(tt.pages) {
+					links = fmt.Sprintf(`[{"rel": "next", "href": "%snetworks?marker=%d"}]`, fakeServer.Endpoint(), page+1)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"networks": [{"id": "network-%d"}], "networks_links": %s}`, page, links)
+			})
+
+			client := networkClient{serviceClient: fakeclient.ServiceClient(fakeServer)}
+
+			var ids []string
+			var errs []error
+			for network, err := range client.ListNetwork(context.TODO(), networks.ListOpts{}) {
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				ids = append(ids, network.ID)
+			}
+
+			if fmt.Sprint(ids) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("Expected networks %v, got %v", tt.wantIDs, ids)
+			}
+			if tt.wantError && len(errs) != 1 {
+				t.Errorf("Expected exactly one error, got %v", errs)
+			}
+			if !tt.wantError && len(errs) != 0 {
+				t.Errorf("Expected no error, got %v", errs)
+			}
+		})
+	}
+}
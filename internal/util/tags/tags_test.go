@@ -0,0 +1,110 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"go.uber.org/mock/gomock"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients/mock"
+)
+
+func TestReconcileTags(t *testing.T) {
+	replaceErr := errors.New("replace failed")
+
+	testCases := []struct {
+		name         string
+		specTags     []orcv1alpha1.NeutronTag
+		observedTags []string
+		replaceErr   error
+		wantReplace  []string
+		wantRefresh  bool
+		wantErr      error
+	}{
+		{
+			name:         "Tags in sync",
+			specTags:     []orcv1alpha1.NeutronTag{"a", "b"},
+			observedTags: []string{"a", "b"},
+		},
+		{
+			name:         "Tags in sync in a different order",
+			specTags:     []orcv1alpha1.NeutronTag{"b", "a"},
+			observedTags: []string{"a", "b"},
+		},
+		{
+			name:     "No tags",
+			specTags: nil,
+		},
+		{
+			name:         "Tag added",
+			specTags:     []orcv1alpha1.NeutronTag{"c", "a", "b"},
+			observedTags: []string{"a", "b"},
+			wantReplace:  []string{"a", "b", "c"},
+			wantRefresh:  true,
+		},
+		{
+			name:         "All tags removed",
+			specTags:     nil,
+			observedTags: []string{"a", "b"},
+			wantReplace:  []string{},
+			wantRefresh:  true,
+		},
+		{
+			name:         "Replace fails",
+			specTags:     []orcv1alpha1.NeutronTag{"a"},
+			observedTags: []string{"b"},
+			replaceErr:   replaceErr,
+			wantReplace:  []string{"a"},
+			wantErr:      replaceErr,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var replaced []string
+			called := false
+			replacer := func(_ context.Context, tags []string) error {
+				called = true
+				replaced = tags
+				return tt.replaceErr
+			}
+
+			reconciler := ReconcileTags[any, any](tt.specTags, tt.observedTags, replacer)
+			reconcileStatus := reconciler(context.TODO(), nil, nil)
+
+			if tt.wantReplace == nil && called {
+				t.Errorf("Expected no call to the tag replacer, got %v", replaced)
+			}
+			if tt.wantReplace != nil && (!called || !slices.Equal(replaced, tt.wantReplace)) {
+				t.Errorf("Expected tag replacer to be called with %v, got %v (called: %t)", tt.wantReplace, replaced, called)
+			}
+
+			needsReschedule, err := reconcileStatus.NeedsReschedule()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && needsReschedule != tt.wantRefresh {
+				t.Errorf("Expected needsReschedule %t, got %t", tt.wantRefresh, needsReschedule)
+			}
+		})
+	}
+}
+
+func TestNewNeutronTagReplacer(t *testing.T) {
+	mockctrl := gomock.NewController(t)
+	networkClient := mock.NewMockNetworkClient(mockctrl)
+
+	tags := []string{"a", "b"}
+	networkClient.EXPECT().
+		ReplaceAllAttributesTags(gomock.Any(), "networks", "network-id", &attributestags.ReplaceAllOpts{Tags: tags}).
+		Return(tags, nil)
+
+	replacer := NewNeutronTagReplacer(networkClient, "networks", "network-id")
+	if err := replacer(context.TODO(), tags); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}